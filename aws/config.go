@@ -1,8 +1,13 @@
 package aws
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -172,7 +177,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/xray"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	homedir "github.com/mitchellh/go-homedir"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/version"
 )
@@ -202,6 +209,8 @@ type Config struct {
 	Endpoints         map[string]string
 	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
 	Insecure          bool
+	HTTPProxy         string
+	CustomCABundle    string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -451,6 +460,17 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	if c.HTTPProxy != "" || c.CustomCABundle != "" {
+		httpClient, err := c.httpClient()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider HTTP client: %w", err)
+		}
+
+		// Every service client below is built from a copy of this session,
+		// so they all share the proxy and trust roots.
+		sess = sess.Copy(&aws.Config{HTTPClient: httpClient})
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -890,6 +910,49 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// httpClient returns an HTTP client which honors the provider-level
+// http_proxy, custom_ca_bundle and insecure settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle filename (%s): %w", c.CustomCABundle, err)
+		}
+
+		pem, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error loading custom CA bundle (%s): no valid PEM certificates found", filename)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL (%s): %w", c.HTTPProxy, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return client, nil
+}
+
 func hasEc2Classic(platforms []string) bool {
 	for _, p := range platforms {
 		if p == "EC2" {
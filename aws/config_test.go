@@ -1,10 +1,22 @@
 package aws
 
 import (
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/sts"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
 
@@ -114,6 +126,183 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigClient_HTTPProxyAndCustomCABundle(t *testing.T) {
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	stsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, test_sts_getCallerIdentity_response)
+	}))
+	defer stsServer.Close()
+
+	var proxiedRequests int32
+	proxyServer := httptest.NewServer(testHTTPConnectProxyHandler(t, &proxiedRequests))
+	defer proxyServer.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: stsServer.Certificate().Raw})
+	if err := ioutil.WriteFile(caBundle, certPEM, 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	testCases := []struct {
+		Description         string
+		Config              *Config
+		ExpectedProxiedReqs int32
+		ExpectedErr         string
+	}{
+		{
+			Description:         "proxy without custom CA bundle",
+			Config:              &Config{HTTPProxy: proxyServer.URL},
+			ExpectedProxiedReqs: 1,
+			ExpectedErr:         "certificate",
+		},
+		{
+			Description:         "custom CA bundle without proxy",
+			Config:              &Config{CustomCABundle: caBundle},
+			ExpectedProxiedReqs: 0,
+		},
+		{
+			Description:         "proxy with custom CA bundle",
+			Config:              &Config{HTTPProxy: proxyServer.URL, CustomCABundle: caBundle},
+			ExpectedProxiedReqs: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Description, func(t *testing.T) {
+			atomic.StoreInt32(&proxiedRequests, 0)
+
+			config := testCase.Config
+			config.AccessKey = "MockAccessKey"
+			config.SecretKey = "MockSecretKey"
+			config.Region = "us-east-1" //lintignore:AWSAT003
+			config.Endpoints = map[string]string{"sts": stsServer.URL}
+			config.MaxRetries = 1
+			config.SkipCredsValidation = true
+			config.SkipGetEC2Platforms = true
+			config.SkipMetadataApiCheck = true
+			config.SkipRequestingAccountId = true
+
+			raw, err := config.Client()
+			if err != nil {
+				t.Fatalf("error configuring client: %s", err)
+			}
+
+			output, err := raw.(*AWSClient).stsconn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if testCase.ExpectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedErr) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.ExpectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got, want := aws.StringValue(output.Account), "123456789012"; got != want {
+					t.Errorf("expected account %q, got %q", want, got)
+				}
+			}
+
+			if got := atomic.LoadInt32(&proxiedRequests); got < testCase.ExpectedProxiedReqs || (testCase.ExpectedProxiedReqs == 0 && got != 0) {
+				t.Errorf("expected %d proxied connections, got %d", testCase.ExpectedProxiedReqs, got)
+			}
+		})
+	}
+}
+
+func TestConfigHTTPClient_InvalidCustomCABundle(t *testing.T) {
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	if err := ioutil.WriteFile(caBundle, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	config := &Config{CustomCABundle: caBundle}
+
+	if _, err := config.httpClient(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+// testHTTPConnectProxyHandler returns a handler that tunnels HTTP CONNECT
+// requests to their destination, counting each tunnel it opens.
+func testHTTPConnectProxyHandler(t *testing.T, count *int32) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "only CONNECT is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		atomic.AddInt32(count, 1)
+
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			t.Error("proxy response writer does not support hijacking")
+			upstream.Close()
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		downstream, _, err := hijacker.Hijack()
+		if err != nil {
+			t.Errorf("error hijacking proxy connection: %s", err)
+			upstream.Close()
+			return
+		}
+
+		go func() {
+			defer upstream.Close()
+			defer downstream.Close()
+			_, _ = io.Copy(upstream, downstream)
+		}()
+		go func() {
+			_, _ = io.Copy(downstream, upstream)
+		}()
+	})
+}
+
+// stashEnv clears the process environment, returning the previous values.
+func stashEnv() []string {
+	env := os.Environ()
+	os.Clearenv()
+	return env
+}
+
+// popEnv restores a process environment saved with stashEnv.
+func popEnv(env []string) {
+	os.Clearenv()
+
+	for _, e := range env {
+		p := strings.SplitN(e, "=", 2)
+		k, v := p[0], ""
+		if len(p) > 1 {
+			v = p[1]
+		}
+		os.Setenv(k, v)
+	}
+}
+
+//lintignore:AWSAT005
+const test_sts_getCallerIdentity_response = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/Alice</Arn>
+    <UserId>AKIAI44QH8DHBEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
 var test_ec2_describeAccountAttributes_response = `<DescribeAccountAttributesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
   <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
   <accountAttributeSet>
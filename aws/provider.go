@@ -140,6 +140,24 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"http_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"HTTPS_PROXY",
+					"HTTP_PROXY",
+				}, ""),
+				Description:  descriptions["http_proxy"],
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -1040,6 +1058,15 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_object_lock_configuration":                 resourceAwsS3BucketObjectLockConfiguration(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3_object_copy":                                      resourceAwsS3ObjectCopy(),
 			"aws_s3control_bucket":                                    resourceAwsS3ControlBucket(),
 			"aws_s3control_bucket_policy":                             resourceAwsS3ControlBucketPolicy(),
@@ -1269,6 +1296,12 @@ func init() {
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
+		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
+			"Can also be configured using the `HTTPS_PROXY` or `HTTP_PROXY` environment variables.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
 
@@ -1465,6 +1498,8 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
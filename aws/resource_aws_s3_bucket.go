@@ -88,8 +88,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 
 			"acl": {
 				Type:          schema.TypeString,
-				Default:       "private",
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"grant"},
 				ValidateFunc:  validation.StringInSlice(BucketCannedACL_Values(), false),
 			},
@@ -97,39 +97,10 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
+				Computed:      true,
 				Set:           grantHash,
 				ConflictsWith: []string{"acl"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							// TypeAmazonCustomerByEmail is not currently supported
-							ValidateFunc: validation.StringInSlice([]string{
-								s3.TypeCanonicalUser,
-								s3.TypeGroup,
-							}, false),
-						},
-						"uri": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						"permissions": {
-							Type:     schema.TypeSet,
-							Required: true,
-							Set:      schema.HashString,
-							Elem: &schema.Schema{
-								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
-							},
-						},
-					},
-				},
+				Elem:          s3BucketGrantSchema(),
 			},
 
 			"policy": {
@@ -142,39 +113,14 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"cors_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"allowed_headers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"allowed_methods": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"allowed_origins": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"expose_headers": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"max_age_seconds": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-					},
-				},
+				Computed: true,
+				Elem:     s3BucketCorsRuleSchema(),
 			},
 
 			"website": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -237,25 +183,13 @@ func resourceAwsS3Bucket() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"mfa_delete": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-					},
-				},
+				Elem:     s3BucketVersioningSchema(),
 			},
 
 			"logging": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"target_bucket": {
@@ -280,109 +214,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"lifecycle_rule": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(0, 255),
-						},
-						"prefix": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"tags": tagsSchema(),
-						"enabled": {
-							Type:     schema.TypeBool,
-							Required: true,
-						},
-						"abort_incomplete_multipart_upload_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"expiration": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"date": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateS3BucketLifecycleTimestamp,
-									},
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"expired_object_delete_marker": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"noncurrent_version_expiration": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(1),
-									},
-								},
-							},
-						},
-						"transition": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      transitionHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"date": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validateS3BucketLifecycleTimestamp,
-									},
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"storage_class": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleTransitionStorageClass(),
-									},
-								},
-							},
-						},
-						"noncurrent_version_transition": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Set:      transitionHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"days": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-									"storage_class": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateS3BucketLifecycleTransitionStorageClass(),
-									},
-								},
-							},
-						},
-					},
-				},
+				Computed: true,
+				Elem:     s3BucketLifecycleRuleSchema(),
 			},
 
 			"force_destroy": {
@@ -408,6 +241,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"replication_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -419,118 +253,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 							Type:     schema.TypeSet,
 							Required: true,
 							Set:      rulesHash,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"id": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringLenBetween(0, 255),
-									},
-									"destination": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										MinItems: 1,
-										Required: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"account_id": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ValidateFunc: validateAwsAccountId,
-												},
-												"bucket": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validateArn,
-												},
-												"storage_class": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ValidateFunc: validation.StringInSlice(s3.StorageClass_Values(), false),
-												},
-												"replica_kms_key_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"access_control_translation": {
-													Type:     schema.TypeList,
-													Optional: true,
-													MinItems: 1,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"owner": {
-																Type:         schema.TypeString,
-																Required:     true,
-																ValidateFunc: validation.StringInSlice(s3.OwnerOverride_Values(), false),
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"source_selection_criteria": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"sse_kms_encrypted_objects": {
-													Type:     schema.TypeList,
-													Optional: true,
-													MinItems: 1,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															"enabled": {
-																Type:     schema.TypeBool,
-																Required: true,
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									"prefix": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringLenBetween(0, 1024),
-									},
-									"status": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(s3.ReplicationRuleStatus_Values(), false),
-									},
-									"priority": {
-										Type:     schema.TypeInt,
-										Optional: true,
-									},
-									"filter": {
-										Type:     schema.TypeList,
-										Optional: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"prefix": {
-													Type:         schema.TypeString,
-													Optional:     true,
-													ValidateFunc: validation.StringLenBetween(0, 1024),
-												},
-												"tags": tagsSchema(),
-											},
-										},
-									},
-									"delete_marker_replication_status": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringInSlice([]string{s3.DeleteMarkerReplicationStatusEnabled}, false),
-									},
-								},
-							},
+							Elem:     s3BucketReplicationRuleSchema(),
 						},
 					},
 				},
@@ -540,38 +263,14 @@ func resourceAwsS3Bucket() *schema.Resource {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"rule": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
 							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"apply_server_side_encryption_by_default": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Required: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"kms_master_key_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"sse_algorithm": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
-												},
-											},
-										},
-									},
-									"bucket_key_enabled": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-								},
-							},
+							Elem:     s3BucketServerSideEncryptionRuleSchema(),
 						},
 					},
 				},
@@ -580,6 +279,7 @@ func resourceAwsS3Bucket() *schema.Resource {
 			"object_lock_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -593,38 +293,9 @@ func resourceAwsS3Bucket() *schema.Resource {
 						"rule": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"default_retention": {
-										Type:     schema.TypeList,
-										Required: true,
-										MinItems: 1,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"mode": {
-													Type:         schema.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
-												},
-
-												"days": {
-													Type:         schema.TypeInt,
-													Optional:     true,
-													ValidateFunc: validation.IntAtLeast(1),
-												},
-
-												"years": {
-													Type:         schema.TypeInt,
-													Optional:     true,
-													ValidateFunc: validation.IntAtLeast(1),
-												},
-											},
-										},
-									},
-								},
-							},
+							Elem:     s3BucketObjectLockRuleSchema(),
 						},
 					},
 				},
@@ -924,21 +595,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	corsRules := make([]map[string]interface{}, 0)
 	if cors, ok := corsResponse.(*s3.GetBucketCorsOutput); ok && len(cors.CORSRules) > 0 {
-		corsRules = make([]map[string]interface{}, 0, len(cors.CORSRules))
-		for _, ruleObject := range cors.CORSRules {
-			rule := make(map[string]interface{})
-			rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
-			rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
-			rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
-			// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
-			if ruleObject.AllowedOrigins != nil {
-				rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
-			}
-			if ruleObject.MaxAgeSeconds != nil {
-				rule["max_age_seconds"] = int(aws.Int64Value(ruleObject.MaxAgeSeconds))
-			}
-			corsRules = append(corsRules, rule)
-		}
+		corsRules = flattenS3CorsRules(cors.CORSRules)
 	}
 	if err := d.Set("cors_rule", corsRules); err != nil {
 		return fmt.Errorf("error setting cors_rule: %s", err)
@@ -956,48 +613,9 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	websites := make([]map[string]interface{}, 0, 1)
 	if ws, ok := wsResponse.(*s3.GetBucketWebsiteOutput); ok {
-		w := make(map[string]interface{})
-
-		if v := ws.IndexDocument; v != nil {
-			w["index_document"] = aws.StringValue(v.Suffix)
-		}
-
-		if v := ws.ErrorDocument; v != nil {
-			w["error_document"] = aws.StringValue(v.Key)
-		}
-
-		if v := ws.RedirectAllRequestsTo; v != nil {
-			if v.Protocol == nil {
-				w["redirect_all_requests_to"] = aws.StringValue(v.HostName)
-			} else {
-				var host string
-				var path string
-				var query string
-				parsedHostName, err := url.Parse(aws.StringValue(v.HostName))
-				if err == nil {
-					host = parsedHostName.Host
-					path = parsedHostName.Path
-					query = parsedHostName.RawQuery
-				} else {
-					host = aws.StringValue(v.HostName)
-					path = ""
-				}
-
-				w["redirect_all_requests_to"] = (&url.URL{
-					Host:     host,
-					Path:     path,
-					Scheme:   aws.StringValue(v.Protocol),
-					RawQuery: query,
-				}).String()
-			}
-		}
-
-		if v := ws.RoutingRules; v != nil {
-			rr, err := normalizeRoutingRules(v)
-			if err != nil {
-				return fmt.Errorf("Error while marshaling routing rules: %s", err)
-			}
-			w["routing_rules"] = rr
+		w, err := flattenS3WebsiteConfiguration(ws)
+		if err != nil {
+			return err
 		}
 
 		// We have special handling for the website configuration,
@@ -1023,19 +641,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	vcl := make([]map[string]interface{}, 0, 1)
 	if versioning, ok := versioningResponse.(*s3.GetBucketVersioningOutput); ok {
-		vc := make(map[string]interface{})
-		if versioning.Status != nil && aws.StringValue(versioning.Status) == s3.BucketVersioningStatusEnabled {
-			vc["enabled"] = true
-		} else {
-			vc["enabled"] = false
-		}
-
-		if versioning.MFADelete != nil && aws.StringValue(versioning.MFADelete) == s3.MFADeleteEnabled {
-			vc["mfa_delete"] = true
-		} else {
-			vc["mfa_delete"] = false
-		}
-		vcl = append(vcl, vc)
+		vcl = append(vcl, flattenS3VersioningConfiguration(versioning))
 	}
 	if err := d.Set("versioning", vcl); err != nil {
 		return fmt.Errorf("error setting versioning: %s", err)
@@ -1086,15 +692,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	lcl := make([]map[string]interface{}, 0, 1)
 	if logging, ok := loggingResponse.(*s3.GetBucketLoggingOutput); ok && logging.LoggingEnabled != nil {
-		v := logging.LoggingEnabled
-		lc := make(map[string]interface{})
-		if aws.StringValue(v.TargetBucket) != "" {
-			lc["target_bucket"] = aws.StringValue(v.TargetBucket)
-		}
-		if aws.StringValue(v.TargetPrefix) != "" {
-			lc["target_prefix"] = aws.StringValue(v.TargetPrefix)
-		}
-		lcl = append(lcl, lc)
+		lcl = append(lcl, flattenS3LoggingEnabled(logging.LoggingEnabled))
 	}
 	if err := d.Set("logging", lcl); err != nil {
 		return fmt.Errorf("error setting logging: %s", err)
@@ -1113,117 +711,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	lifecycleRules := make([]map[string]interface{}, 0)
 	if lifecycle, ok := lifecycleResponse.(*s3.GetBucketLifecycleConfigurationOutput); ok && len(lifecycle.Rules) > 0 {
-		lifecycleRules = make([]map[string]interface{}, 0, len(lifecycle.Rules))
-
-		for _, lifecycleRule := range lifecycle.Rules {
-			log.Printf("[DEBUG] S3 bucket: %s, read lifecycle rule: %v", d.Id(), lifecycleRule)
-			rule := make(map[string]interface{})
-
-			// ID
-			if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
-				rule["id"] = aws.StringValue(lifecycleRule.ID)
-			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.And.Prefix)
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = keyvaluetags.S3KeyValueTags(filter.And.Tags).IgnoreAws().Map()
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.Prefix)
-					}
-					// Tag
-					if filter.Tag != nil {
-						rule["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAws().Map()
-					}
-				}
-			} else {
-				if lifecycleRule.Prefix != nil {
-					rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
-				}
-			}
-
-			// Enabled
-			if lifecycleRule.Status != nil {
-				if aws.StringValue(lifecycleRule.Status) == s3.ExpirationStatusEnabled {
-					rule["enabled"] = true
-				} else {
-					rule["enabled"] = false
-				}
-			}
-
-			// AbortIncompleteMultipartUploadDays
-			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
-				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
-					rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
-				}
-			}
-
-			// expiration
-			if lifecycleRule.Expiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.Expiration.Date != nil {
-					e["date"] = (aws.TimeValue(lifecycleRule.Expiration.Date)).Format("2006-01-02")
-				}
-				if lifecycleRule.Expiration.Days != nil {
-					e["days"] = int(aws.Int64Value(lifecycleRule.Expiration.Days))
-				}
-				if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
-					e["expired_object_delete_marker"] = aws.BoolValue(lifecycleRule.Expiration.ExpiredObjectDeleteMarker)
-				}
-				rule["expiration"] = []interface{}{e}
-			}
-			// noncurrent_version_expiration
-			if lifecycleRule.NoncurrentVersionExpiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
-					e["days"] = int(aws.Int64Value(lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays))
-				}
-				rule["noncurrent_version_expiration"] = []interface{}{e}
-			}
-			//// transition
-			if len(lifecycleRule.Transitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
-				for _, v := range lifecycleRule.Transitions {
-					t := make(map[string]interface{})
-					if v.Date != nil {
-						t["date"] = (aws.TimeValue(v.Date)).Format("2006-01-02")
-					}
-					if v.Days != nil {
-						t["days"] = int(aws.Int64Value(v.Days))
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = aws.StringValue(v.StorageClass)
-					}
-					transitions = append(transitions, t)
-				}
-				rule["transition"] = schema.NewSet(transitionHash, transitions)
-			}
-			// noncurrent_version_transition
-			if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
-				for _, v := range lifecycleRule.NoncurrentVersionTransitions {
-					t := make(map[string]interface{})
-					if v.NoncurrentDays != nil {
-						t["days"] = int(aws.Int64Value(v.NoncurrentDays))
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = aws.StringValue(v.StorageClass)
-					}
-					transitions = append(transitions, t)
-				}
-				rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
-			}
-
-			lifecycleRules = append(lifecycleRules, rule)
-		}
+		lifecycleRules = flattenS3LifecycleRules(lifecycle.Rules)
 	}
 	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
 		return fmt.Errorf("error setting lifecycle_rule: %s", err)
@@ -1467,62 +955,45 @@ func resourceAwsS3BucketGrantsUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 			return fmt.Errorf("Error fallback to canned ACL, %s", err)
 		}
 	} else {
-		apResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-			return s3conn.GetBucketAcl(&s3.GetBucketAclInput{
-				Bucket: aws.String(d.Id()),
-			})
-		})
-
-		if err != nil {
-			return fmt.Errorf("error getting S3 Bucket (%s) ACL: %s", d.Id(), err)
+		if err := putS3BucketGrants(s3conn, bucket, rawGrants); err != nil {
+			return fmt.Errorf("Error putting S3 Grants: %s", err)
 		}
 
-		ap := apResponse.(*s3.GetBucketAclOutput)
-		log.Printf("[DEBUG] S3 bucket: %s, read ACL grants policy: %+v", d.Id(), ap)
-
-		grants := make([]*s3.Grant, 0, len(rawGrants))
-		for _, rawGrant := range rawGrants {
-			log.Printf("[DEBUG] S3 bucket: %s, put grant: %#v", bucket, rawGrant)
-			grantMap := rawGrant.(map[string]interface{})
-			for _, rawPermission := range grantMap["permissions"].(*schema.Set).List() {
-				ge := &s3.Grantee{}
-				if i, ok := grantMap["id"].(string); ok && i != "" {
-					ge.SetID(i)
-				}
-				if t, ok := grantMap["type"].(string); ok && t != "" {
-					ge.SetType(t)
-				}
-				if u, ok := grantMap["uri"].(string); ok && u != "" {
-					ge.SetURI(u)
-				}
-
-				g := &s3.Grant{
-					Grantee:    ge,
-					Permission: aws.String(rawPermission.(string)),
-				}
-				grants = append(grants, g)
-			}
-		}
+		// The canned ACL no longer describes the bucket once explicit grants are applied.
+		d.Set("acl", "")
+	}
+	return nil
+}
 
-		grantsInput := &s3.PutBucketAclInput{
+func putS3BucketGrants(s3conn *s3.S3, bucket string, rawGrants []interface{}) error {
+	apResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketAcl(&s3.GetBucketAclInput{
 			Bucket: aws.String(bucket),
-			AccessControlPolicy: &s3.AccessControlPolicy{
-				Grants: grants,
-				Owner:  ap.Owner,
-			},
-		}
+		})
+	})
 
-		log.Printf("[DEBUG] S3 bucket: %s, put Grants: %#v", bucket, grantsInput)
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) ACL: %w", bucket, err)
+	}
 
-		_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
-			return s3conn.PutBucketAcl(grantsInput)
-		})
+	ap := apResponse.(*s3.GetBucketAclOutput)
+	log.Printf("[DEBUG] S3 bucket: %s, read ACL grants policy: %+v", bucket, ap)
 
-		if err != nil {
-			return fmt.Errorf("Error putting S3 Grants: %s", err)
-		}
+	grantsInput := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+		AccessControlPolicy: &s3.AccessControlPolicy{
+			Grants: expandS3BucketGrants(rawGrants),
+			Owner:  ap.Owner,
+		},
 	}
-	return nil
+
+	log.Printf("[DEBUG] S3 bucket: %s, put Grants: %#v", bucket, grantsInput)
+
+	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketAcl(grantsInput)
+	})
+
+	return err
 }
 
 func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
@@ -1543,39 +1014,10 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		}
 	} else {
 		// Put CORS
-		rules := make([]*s3.CORSRule, 0, len(rawCors))
-		for _, cors := range rawCors {
-			corsMap := cors.(map[string]interface{})
-			r := &s3.CORSRule{}
-			for k, v := range corsMap {
-				log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v, %#v", bucket, k, v)
-				if k == "max_age_seconds" {
-					r.MaxAgeSeconds = aws.Int64(int64(v.(int)))
-				} else {
-					vMap := make([]*string, len(v.([]interface{})))
-					for i, vv := range v.([]interface{}) {
-						if str, ok := vv.(string); ok {
-							vMap[i] = aws.String(str)
-						}
-					}
-					switch k {
-					case "allowed_headers":
-						r.AllowedHeaders = vMap
-					case "allowed_methods":
-						r.AllowedMethods = vMap
-					case "allowed_origins":
-						r.AllowedOrigins = vMap
-					case "expose_headers":
-						r.ExposeHeaders = vMap
-					}
-				}
-			}
-			rules = append(rules, r)
-		}
 		corsInput := &s3.PutBucketCorsInput{
 			Bucket: aws.String(bucket),
 			CORSConfiguration: &s3.CORSConfiguration{
-				CORSRules: rules,
+				CORSRules: expandS3CorsRules(rawCors),
 			},
 		}
 		log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v", bucket, corsInput)
@@ -1610,58 +1052,9 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
 	bucket := d.Get("bucket").(string)
 
-	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
-	if v, ok := website["index_document"]; ok {
-		indexDocument = v.(string)
-	}
-	if v, ok := website["error_document"]; ok {
-		errorDocument = v.(string)
-	}
-	if v, ok := website["redirect_all_requests_to"]; ok {
-		redirectAllRequestsTo = v.(string)
-	}
-	if v, ok := website["routing_rules"]; ok {
-		routingRules = v.(string)
-	}
-
-	if indexDocument == "" && redirectAllRequestsTo == "" {
-		return fmt.Errorf("Must specify either index_document or redirect_all_requests_to.")
-	}
-
-	websiteConfiguration := &s3.WebsiteConfiguration{}
-
-	if indexDocument != "" {
-		websiteConfiguration.IndexDocument = &s3.IndexDocument{Suffix: aws.String(indexDocument)}
-	}
-
-	if errorDocument != "" {
-		websiteConfiguration.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorDocument)}
-	}
-
-	if redirectAllRequestsTo != "" {
-		redirect, err := url.Parse(redirectAllRequestsTo)
-		if err == nil && redirect.Scheme != "" {
-			var redirectHostBuf bytes.Buffer
-			redirectHostBuf.WriteString(redirect.Host)
-			if redirect.Path != "" {
-				redirectHostBuf.WriteString(redirect.Path)
-			}
-			if redirect.RawQuery != "" {
-				redirectHostBuf.WriteString("?")
-				redirectHostBuf.WriteString(redirect.RawQuery)
-			}
-			websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{HostName: aws.String(redirectHostBuf.String()), Protocol: aws.String(redirect.Scheme)}
-		} else {
-			websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{HostName: aws.String(redirectAllRequestsTo)}
-		}
-	}
-
-	if routingRules != "" {
-		var unmarshaledRules []*s3.RoutingRule
-		if err := json.Unmarshal([]byte(routingRules), &unmarshaledRules); err != nil {
-			return err
-		}
-		websiteConfiguration.RoutingRules = unmarshaledRules
+	websiteConfiguration, err := expandS3WebsiteConfiguration(website)
+	if err != nil {
+		return err
 	}
 
 	putInput := &s3.PutBucketWebsiteInput{
@@ -1671,7 +1064,7 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 
 	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
 
-	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return s3conn.PutBucketWebsite(putInput)
 	})
 	if err != nil {
@@ -1707,8 +1100,10 @@ func websiteEndpoint(client *AWSClient, d *schema.ResourceData) (*S3Website, err
 		return nil, nil
 	}
 
-	bucket := d.Get("bucket").(string)
+	return bucketWebsiteEndpoint(client, d.Get("bucket").(string))
+}
 
+func bucketWebsiteEndpoint(client *AWSClient, bucket string) (*S3Website, error) {
 	// Lookup the region for this bucket
 
 	locationResponse, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
@@ -1802,32 +1197,11 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 }
 
 func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
-	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
-	vc := &s3.VersioningConfiguration{}
-
-	if len(v) > 0 {
-		c := v[0].(map[string]interface{})
-
-		if c["enabled"].(bool) {
-			vc.Status = aws.String(s3.BucketVersioningStatusEnabled)
-		} else {
-			vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
-		}
-
-		if c["mfa_delete"].(bool) {
-			vc.MFADelete = aws.String(s3.MFADeleteEnabled)
-		} else {
-			vc.MFADelete = aws.String(s3.MFADeleteDisabled)
-		}
-
-	} else {
-		vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
-	}
 
 	i := &s3.PutBucketVersioningInput{
 		Bucket:                  aws.String(bucket),
-		VersioningConfiguration: vc,
+		VersioningConfiguration: expandS3VersioningConfiguration(d.Get("versioning").([]interface{})),
 	}
 	log.Printf("[DEBUG] S3 put bucket versioning: %#v", i)
 
@@ -1847,17 +1221,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	loggingStatus := &s3.BucketLoggingStatus{}
 
 	if len(logging) > 0 {
-		c := logging[0].(map[string]interface{})
-
-		loggingEnabled := &s3.LoggingEnabled{}
-		if val, ok := c["target_bucket"]; ok {
-			loggingEnabled.TargetBucket = aws.String(val.(string))
-		}
-		if val, ok := c["target_prefix"]; ok {
-			loggingEnabled.TargetPrefix = aws.String(val.(string))
-		}
-
-		loggingStatus.LoggingEnabled = loggingEnabled
+		loggingStatus.LoggingEnabled = expandS3LoggingEnabled(logging[0].(map[string]interface{}))
 	}
 
 	i := &s3.PutBucketLoggingInput{
@@ -1938,33 +1302,10 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 
 	c := serverSideEncryptionConfiguration[0].(map[string]interface{})
 
-	rc := &s3.ServerSideEncryptionConfiguration{}
-
-	rcRules := c["rule"].([]interface{})
-	var rules []*s3.ServerSideEncryptionRule
-	for _, v := range rcRules {
-		rr := v.(map[string]interface{})
-		rrDefault := rr["apply_server_side_encryption_by_default"].([]interface{})
-		sseAlgorithm := rrDefault[0].(map[string]interface{})["sse_algorithm"].(string)
-		kmsMasterKeyId := rrDefault[0].(map[string]interface{})["kms_master_key_id"].(string)
-		rcDefaultRule := &s3.ServerSideEncryptionByDefault{
-			SSEAlgorithm: aws.String(sseAlgorithm),
-		}
-		if kmsMasterKeyId != "" {
-			rcDefaultRule.KMSMasterKeyID = aws.String(kmsMasterKeyId)
-		}
-		rcRule := &s3.ServerSideEncryptionRule{
-			ApplyServerSideEncryptionByDefault: rcDefaultRule,
-		}
-
-		if val, ok := rr["bucket_key_enabled"].(bool); ok {
-			rcRule.BucketKeyEnabled = aws.Bool(val)
-		}
-
-		rules = append(rules, rcRule)
+	rc := &s3.ServerSideEncryptionConfiguration{
+		Rules: expandS3ServerSideEncryptionRules(c["rule"].([]interface{})),
 	}
 
-	rc.Rules = rules
 	i := &s3.PutBucketEncryptionInput{
 		Bucket:                            aws.String(bucket),
 		ServerSideEncryptionConfiguration: rc,
@@ -2028,111 +1369,14 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
 	}
 
-	c := replicationConfiguration[0].(map[string]interface{})
-
-	rc := &s3.ReplicationConfiguration{}
-	if val, ok := c["role"]; ok {
-		rc.Role = aws.String(val.(string))
+	if err := putS3BucketReplicationConfiguration(s3conn, bucket, expandS3ReplicationConfiguration(replicationConfiguration[0].(map[string]interface{}))); err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
 	}
 
-	rcRules := c["rules"].(*schema.Set).List()
-	rules := []*s3.ReplicationRule{}
-	for _, v := range rcRules {
-		rr := v.(map[string]interface{})
-		rcRule := &s3.ReplicationRule{}
-		if status, ok := rr["status"]; ok && status != "" {
-			rcRule.Status = aws.String(status.(string))
-		} else {
-			continue
-		}
-
-		if rrid, ok := rr["id"]; ok && rrid != "" {
-			rcRule.ID = aws.String(rrid.(string))
-		}
-
-		ruleDestination := &s3.Destination{}
-		if dest, ok := rr["destination"].([]interface{}); ok && len(dest) > 0 {
-			if dest[0] != nil {
-				bd := dest[0].(map[string]interface{})
-				ruleDestination.Bucket = aws.String(bd["bucket"].(string))
-
-				if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
-					ruleDestination.StorageClass = aws.String(storageClass.(string))
-				}
-
-				if replicaKmsKeyId, ok := bd["replica_kms_key_id"]; ok && replicaKmsKeyId != "" {
-					ruleDestination.EncryptionConfiguration = &s3.EncryptionConfiguration{
-						ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
-					}
-				}
-
-				if account, ok := bd["account_id"]; ok && account != "" {
-					ruleDestination.Account = aws.String(account.(string))
-				}
-
-				if aclTranslation, ok := bd["access_control_translation"].([]interface{}); ok && len(aclTranslation) > 0 {
-					aclTranslationValues := aclTranslation[0].(map[string]interface{})
-					ruleAclTranslation := &s3.AccessControlTranslation{}
-					ruleAclTranslation.Owner = aws.String(aclTranslationValues["owner"].(string))
-					ruleDestination.AccessControlTranslation = ruleAclTranslation
-				}
-			}
-		}
-		rcRule.Destination = ruleDestination
-
-		if ssc, ok := rr["source_selection_criteria"].([]interface{}); ok && len(ssc) > 0 {
-			if ssc[0] != nil {
-				sscValues := ssc[0].(map[string]interface{})
-				ruleSsc := &s3.SourceSelectionCriteria{}
-				if sseKms, ok := sscValues["sse_kms_encrypted_objects"].([]interface{}); ok && len(sseKms) > 0 {
-					if sseKms[0] != nil {
-						sseKmsValues := sseKms[0].(map[string]interface{})
-						sseKmsEncryptedObjects := &s3.SseKmsEncryptedObjects{}
-						if sseKmsValues["enabled"].(bool) {
-							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusEnabled)
-						} else {
-							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusDisabled)
-						}
-						ruleSsc.SseKmsEncryptedObjects = sseKmsEncryptedObjects
-					}
-				}
-				rcRule.SourceSelectionCriteria = ruleSsc
-			}
-		}
-
-		if f, ok := rr["filter"].([]interface{}); ok && len(f) > 0 && f[0] != nil {
-			// XML schema V2.
-			rcRule.Priority = aws.Int64(int64(rr["priority"].(int)))
-			rcRule.Filter = &s3.ReplicationRuleFilter{}
-			filter := f[0].(map[string]interface{})
-			tags := keyvaluetags.New(filter["tags"]).IgnoreAws().S3Tags()
-			if len(tags) > 0 {
-				rcRule.Filter.And = &s3.ReplicationRuleAndOperator{
-					Prefix: aws.String(filter["prefix"].(string)),
-					Tags:   tags,
-				}
-			} else {
-				rcRule.Filter.Prefix = aws.String(filter["prefix"].(string))
-			}
-
-			if dmr, ok := rr["delete_marker_replication_status"].(string); ok && dmr != "" {
-				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
-					Status: aws.String(dmr),
-				}
-			} else {
-				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
-					Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
-				}
-			}
-		} else {
-			// XML schema V1.
-			rcRule.Prefix = aws.String(rr["prefix"].(string))
-		}
-
-		rules = append(rules, rcRule)
-	}
+	return nil
+}
 
-	rc.Rules = rules
+func putS3BucketReplicationConfiguration(s3conn *s3.S3, bucket string, rc *s3.ReplicationConfiguration) error {
 	i := &s3.PutBucketReplicationInput{
 		Bucket:                   aws.String(bucket),
 		ReplicationConfiguration: rc,
@@ -2152,11 +1396,8 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	if isResourceTimeoutError(err) {
 		_, err = s3conn.PutBucketReplication(i)
 	}
-	if err != nil {
-		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
-	}
 
-	return nil
+	return err
 }
 
 func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
@@ -2176,152 +1417,39 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		return nil
 	}
 
-	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
+	rules, err := expandS3LifecycleRules(lifecycleRules)
+	if err != nil {
+		return err
+	}
 
-	for i, lifecycleRule := range lifecycleRules {
-		r := lifecycleRule.(map[string]interface{})
+	i := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
 
-		rule := &s3.LifecycleRule{}
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return s3conn.PutBucketLifecycleConfiguration(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
+	}
 
-		// Filter
-		tags := keyvaluetags.New(r["tags"]).IgnoreAws().S3Tags()
-		filter := &s3.LifecycleRuleFilter{}
-		if len(tags) > 0 {
-			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
-			lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
-			lifecycleRuleAndOp.SetTags(tags)
-			filter.SetAnd(lifecycleRuleAndOp)
-		} else {
-			filter.SetPrefix(r["prefix"].(string))
-		}
-		rule.SetFilter(filter)
+	return nil
+}
 
-		// ID
-		if val, ok := r["id"].(string); ok && val != "" {
-			rule.ID = aws.String(val)
-		} else {
-			rule.ID = aws.String(resource.PrefixedUniqueId("tf-s3-lifecycle-"))
-		}
+func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
+	var encryptionConfiguration []map[string]interface{}
+	encryptionConfiguration = append(encryptionConfiguration, map[string]interface{}{
+		"rule": flattenS3ServerSideEncryptionRules(c.Rules),
+	})
+	return encryptionConfiguration
+}
 
-		// Enabled
-		if val, ok := r["enabled"].(bool); ok && val {
-			rule.Status = aws.String(s3.ExpirationStatusEnabled)
-		} else {
-			rule.Status = aws.String(s3.ExpirationStatusDisabled)
-		}
-
-		// AbortIncompleteMultipartUpload
-		if val, ok := r["abort_incomplete_multipart_upload_days"].(int); ok && val > 0 {
-			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
-				DaysAfterInitiation: aws.Int64(int64(val)),
-			}
-		}
-
-		// Expiration
-		expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.expiration", i)).([]interface{})
-		if len(expiration) > 0 && expiration[0] != nil {
-			e := expiration[0].(map[string]interface{})
-			i := &s3.LifecycleExpiration{}
-			if val, ok := e["date"].(string); ok && val != "" {
-				t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
-				if err != nil {
-					return fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
-				}
-				i.Date = aws.Time(t)
-			} else if val, ok := e["days"].(int); ok && val > 0 {
-				i.Days = aws.Int64(int64(val))
-			} else if val, ok := e["expired_object_delete_marker"].(bool); ok {
-				i.ExpiredObjectDeleteMarker = aws.Bool(val)
-			}
-			rule.Expiration = i
-		}
-
-		// NoncurrentVersionExpiration
-		nc_expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_expiration", i)).([]interface{})
-		if len(nc_expiration) > 0 && nc_expiration[0] != nil {
-			e := nc_expiration[0].(map[string]interface{})
-
-			if val, ok := e["days"].(int); ok && val > 0 {
-				rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
-					NoncurrentDays: aws.Int64(int64(val)),
-				}
-			}
-		}
-
-		// Transitions
-		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
-		if len(transitions) > 0 {
-			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
-			for _, transition := range transitions {
-				transition := transition.(map[string]interface{})
-				i := &s3.Transition{}
-				if val, ok := transition["date"].(string); ok && val != "" {
-					t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
-					if err != nil {
-						return fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
-					}
-					i.Date = aws.Time(t)
-				} else if val, ok := transition["days"].(int); ok && val >= 0 {
-					i.Days = aws.Int64(int64(val))
-				}
-				if val, ok := transition["storage_class"].(string); ok && val != "" {
-					i.StorageClass = aws.String(val)
-				}
-
-				rule.Transitions = append(rule.Transitions, i)
-			}
-		}
-		// NoncurrentVersionTransitions
-		nc_transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.noncurrent_version_transition", i)).(*schema.Set).List()
-		if len(nc_transitions) > 0 {
-			rule.NoncurrentVersionTransitions = make([]*s3.NoncurrentVersionTransition, 0, len(nc_transitions))
-			for _, transition := range nc_transitions {
-				transition := transition.(map[string]interface{})
-				i := &s3.NoncurrentVersionTransition{}
-				if val, ok := transition["days"].(int); ok && val >= 0 {
-					i.NoncurrentDays = aws.Int64(int64(val))
-				}
-				if val, ok := transition["storage_class"].(string); ok && val != "" {
-					i.StorageClass = aws.String(val)
-				}
-
-				rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, i)
-			}
-		}
-
-		// As a lifecycle rule requires 1 or more transition/expiration actions,
-		// we explicitly pass a default ExpiredObjectDeleteMarker value to be able to create
-		// the rule while keeping the policy unaffected if the conditions are not met.
-		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil &&
-			rule.Transitions == nil && rule.NoncurrentVersionTransitions == nil &&
-			rule.AbortIncompleteMultipartUpload == nil {
-			rule.Expiration = &s3.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(false)}
-		}
-
-		rules = append(rules, rule)
-	}
-
-	i := &s3.PutBucketLifecycleConfigurationInput{
-		Bucket: aws.String(bucket),
-		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
-			Rules: rules,
-		},
-	}
-
-	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
-		return s3conn.PutBucketLifecycleConfiguration(i)
-	})
-	if err != nil {
-		return fmt.Errorf("Error putting S3 lifecycle: %s", err)
-	}
-
-	return nil
-}
-
-func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionConfiguration) []map[string]interface{} {
-	var encryptionConfiguration []map[string]interface{}
-	rules := make([]interface{}, 0, len(c.Rules))
-	for _, v := range c.Rules {
+func flattenS3ServerSideEncryptionRules(c []*s3.ServerSideEncryptionRule) []interface{} {
+	rules := make([]interface{}, 0, len(c))
+	for _, v := range c {
 		if v.ApplyServerSideEncryptionByDefault != nil {
 			r := make(map[string]interface{})
 			d := make(map[string]interface{})
@@ -2332,10 +1460,7 @@ func flattenAwsS3ServerSideEncryptionConfiguration(c *s3.ServerSideEncryptionCon
 			rules = append(rules, r)
 		}
 	}
-	encryptionConfiguration = append(encryptionConfiguration, map[string]interface{}{
-		"rule": rules,
-	})
-	return encryptionConfiguration
+	return rules
 }
 
 func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration) []map[string]interface{} {
@@ -2817,3 +1942,1001 @@ func flattenGrants(ap *s3.GetBucketAclOutput) []interface{} {
 
 	return grants
 }
+
+func expandS3BucketGrants(rawGrants []interface{}) []*s3.Grant {
+	grants := make([]*s3.Grant, 0, len(rawGrants))
+	for _, rawGrant := range rawGrants {
+		grantMap := rawGrant.(map[string]interface{})
+		for _, rawPermission := range grantMap["permissions"].(*schema.Set).List() {
+			ge := &s3.Grantee{}
+			if i, ok := grantMap["id"].(string); ok && i != "" {
+				ge.SetID(i)
+			}
+			if t, ok := grantMap["type"].(string); ok && t != "" {
+				ge.SetType(t)
+			}
+			if u, ok := grantMap["uri"].(string); ok && u != "" {
+				ge.SetURI(u)
+			}
+
+			g := &s3.Grant{
+				Grantee:    ge,
+				Permission: aws.String(rawPermission.(string)),
+			}
+			grants = append(grants, g)
+		}
+	}
+
+	return grants
+}
+
+func expandS3CorsRules(rawCors []interface{}) []*s3.CORSRule {
+	rules := make([]*s3.CORSRule, 0, len(rawCors))
+	for _, cors := range rawCors {
+		corsMap := cors.(map[string]interface{})
+		r := &s3.CORSRule{}
+		for k, v := range corsMap {
+			if k == "max_age_seconds" {
+				r.MaxAgeSeconds = aws.Int64(int64(v.(int)))
+			} else {
+				vMap := make([]*string, len(v.([]interface{})))
+				for i, vv := range v.([]interface{}) {
+					if str, ok := vv.(string); ok {
+						vMap[i] = aws.String(str)
+					}
+				}
+				switch k {
+				case "allowed_headers":
+					r.AllowedHeaders = vMap
+				case "allowed_methods":
+					r.AllowedMethods = vMap
+				case "allowed_origins":
+					r.AllowedOrigins = vMap
+				case "expose_headers":
+					r.ExposeHeaders = vMap
+				}
+			}
+		}
+		rules = append(rules, r)
+	}
+
+	return rules
+}
+
+func flattenS3CorsRules(corsRules []*s3.CORSRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(corsRules))
+	for _, ruleObject := range corsRules {
+		rule := make(map[string]interface{})
+		rule["allowed_headers"] = flattenStringList(ruleObject.AllowedHeaders)
+		rule["allowed_methods"] = flattenStringList(ruleObject.AllowedMethods)
+		rule["allowed_origins"] = flattenStringList(ruleObject.AllowedOrigins)
+		// Both the "ExposeHeaders" and "MaxAgeSeconds" might not be set.
+		if ruleObject.AllowedOrigins != nil {
+			rule["expose_headers"] = flattenStringList(ruleObject.ExposeHeaders)
+		}
+		if ruleObject.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(aws.Int64Value(ruleObject.MaxAgeSeconds))
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandS3WebsiteConfiguration(website map[string]interface{}) (*s3.WebsiteConfiguration, error) {
+	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
+	if v, ok := website["index_document"]; ok {
+		indexDocument = v.(string)
+	}
+	if v, ok := website["error_document"]; ok {
+		errorDocument = v.(string)
+	}
+	if v, ok := website["redirect_all_requests_to"]; ok {
+		redirectAllRequestsTo = v.(string)
+	}
+	if v, ok := website["routing_rules"]; ok {
+		routingRules = v.(string)
+	}
+
+	if indexDocument == "" && redirectAllRequestsTo == "" {
+		return nil, fmt.Errorf("Must specify either index_document or redirect_all_requests_to.")
+	}
+
+	websiteConfiguration := &s3.WebsiteConfiguration{}
+
+	if indexDocument != "" {
+		websiteConfiguration.IndexDocument = &s3.IndexDocument{Suffix: aws.String(indexDocument)}
+	}
+
+	if errorDocument != "" {
+		websiteConfiguration.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorDocument)}
+	}
+
+	if redirectAllRequestsTo != "" {
+		redirect, err := url.Parse(redirectAllRequestsTo)
+		if err == nil && redirect.Scheme != "" {
+			var redirectHostBuf bytes.Buffer
+			redirectHostBuf.WriteString(redirect.Host)
+			if redirect.Path != "" {
+				redirectHostBuf.WriteString(redirect.Path)
+			}
+			if redirect.RawQuery != "" {
+				redirectHostBuf.WriteString("?")
+				redirectHostBuf.WriteString(redirect.RawQuery)
+			}
+			websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{HostName: aws.String(redirectHostBuf.String()), Protocol: aws.String(redirect.Scheme)}
+		} else {
+			websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{HostName: aws.String(redirectAllRequestsTo)}
+		}
+	}
+
+	if routingRules != "" {
+		var unmarshaledRules []*s3.RoutingRule
+		if err := json.Unmarshal([]byte(routingRules), &unmarshaledRules); err != nil {
+			return nil, err
+		}
+		websiteConfiguration.RoutingRules = unmarshaledRules
+	}
+
+	return websiteConfiguration, nil
+}
+
+func flattenS3WebsiteConfiguration(ws *s3.GetBucketWebsiteOutput) (map[string]interface{}, error) {
+	w := make(map[string]interface{})
+
+	if v := ws.IndexDocument; v != nil {
+		w["index_document"] = aws.StringValue(v.Suffix)
+	}
+
+	if v := ws.ErrorDocument; v != nil {
+		w["error_document"] = aws.StringValue(v.Key)
+	}
+
+	if v := ws.RedirectAllRequestsTo; v != nil {
+		if v.Protocol == nil {
+			w["redirect_all_requests_to"] = aws.StringValue(v.HostName)
+		} else {
+			var host string
+			var path string
+			var query string
+			parsedHostName, err := url.Parse(aws.StringValue(v.HostName))
+			if err == nil {
+				host = parsedHostName.Host
+				path = parsedHostName.Path
+				query = parsedHostName.RawQuery
+			} else {
+				host = aws.StringValue(v.HostName)
+				path = ""
+			}
+
+			w["redirect_all_requests_to"] = (&url.URL{
+				Host:     host,
+				Path:     path,
+				Scheme:   aws.StringValue(v.Protocol),
+				RawQuery: query,
+			}).String()
+		}
+	}
+
+	if v := ws.RoutingRules; v != nil {
+		rr, err := normalizeRoutingRules(v)
+		if err != nil {
+			return nil, fmt.Errorf("Error while marshaling routing rules: %s", err)
+		}
+		w["routing_rules"] = rr
+	}
+
+	return w, nil
+}
+
+func expandS3VersioningConfiguration(v []interface{}) *s3.VersioningConfiguration {
+	vc := &s3.VersioningConfiguration{}
+
+	if len(v) > 0 && v[0] != nil {
+		c := v[0].(map[string]interface{})
+
+		if c["enabled"].(bool) {
+			vc.Status = aws.String(s3.BucketVersioningStatusEnabled)
+		} else {
+			vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
+		}
+
+		if c["mfa_delete"].(bool) {
+			vc.MFADelete = aws.String(s3.MFADeleteEnabled)
+		} else {
+			vc.MFADelete = aws.String(s3.MFADeleteDisabled)
+		}
+
+	} else {
+		vc.Status = aws.String(s3.BucketVersioningStatusSuspended)
+	}
+
+	return vc
+}
+
+func flattenS3VersioningConfiguration(versioning *s3.GetBucketVersioningOutput) map[string]interface{} {
+	vc := make(map[string]interface{})
+	if versioning.Status != nil && aws.StringValue(versioning.Status) == s3.BucketVersioningStatusEnabled {
+		vc["enabled"] = true
+	} else {
+		vc["enabled"] = false
+	}
+
+	if versioning.MFADelete != nil && aws.StringValue(versioning.MFADelete) == s3.MFADeleteEnabled {
+		vc["mfa_delete"] = true
+	} else {
+		vc["mfa_delete"] = false
+	}
+
+	return vc
+}
+
+func expandS3LoggingEnabled(c map[string]interface{}) *s3.LoggingEnabled {
+	loggingEnabled := &s3.LoggingEnabled{}
+	if val, ok := c["target_bucket"]; ok {
+		loggingEnabled.TargetBucket = aws.String(val.(string))
+	}
+	if val, ok := c["target_prefix"]; ok {
+		loggingEnabled.TargetPrefix = aws.String(val.(string))
+	}
+
+	return loggingEnabled
+}
+
+func flattenS3LoggingEnabled(v *s3.LoggingEnabled) map[string]interface{} {
+	lc := make(map[string]interface{})
+	if aws.StringValue(v.TargetBucket) != "" {
+		lc["target_bucket"] = aws.StringValue(v.TargetBucket)
+	}
+	if aws.StringValue(v.TargetPrefix) != "" {
+		lc["target_prefix"] = aws.StringValue(v.TargetPrefix)
+	}
+
+	return lc
+}
+
+func expandS3ServerSideEncryptionRules(rcRules []interface{}) []*s3.ServerSideEncryptionRule {
+	var rules []*s3.ServerSideEncryptionRule
+	for _, v := range rcRules {
+		rr := v.(map[string]interface{})
+		rrDefault := rr["apply_server_side_encryption_by_default"].([]interface{})
+		sseAlgorithm := rrDefault[0].(map[string]interface{})["sse_algorithm"].(string)
+		kmsMasterKeyId := rrDefault[0].(map[string]interface{})["kms_master_key_id"].(string)
+		rcDefaultRule := &s3.ServerSideEncryptionByDefault{
+			SSEAlgorithm: aws.String(sseAlgorithm),
+		}
+		if kmsMasterKeyId != "" {
+			rcDefaultRule.KMSMasterKeyID = aws.String(kmsMasterKeyId)
+		}
+		rcRule := &s3.ServerSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: rcDefaultRule,
+		}
+
+		if val, ok := rr["bucket_key_enabled"].(bool); ok {
+			rcRule.BucketKeyEnabled = aws.Bool(val)
+		}
+
+		rules = append(rules, rcRule)
+	}
+
+	return rules
+}
+
+func expandS3ReplicationConfiguration(c map[string]interface{}) *s3.ReplicationConfiguration {
+	rc := &s3.ReplicationConfiguration{}
+	if val, ok := c["role"]; ok {
+		rc.Role = aws.String(val.(string))
+	}
+
+	rcRules := c["rules"].(*schema.Set).List()
+	rules := []*s3.ReplicationRule{}
+	for _, v := range rcRules {
+		rr := v.(map[string]interface{})
+		rcRule := &s3.ReplicationRule{}
+		if status, ok := rr["status"]; ok && status != "" {
+			rcRule.Status = aws.String(status.(string))
+		} else {
+			continue
+		}
+
+		if rrid, ok := rr["id"]; ok && rrid != "" {
+			rcRule.ID = aws.String(rrid.(string))
+		}
+
+		ruleDestination := &s3.Destination{}
+		if dest, ok := rr["destination"].([]interface{}); ok && len(dest) > 0 {
+			if dest[0] != nil {
+				bd := dest[0].(map[string]interface{})
+				ruleDestination.Bucket = aws.String(bd["bucket"].(string))
+
+				if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
+					ruleDestination.StorageClass = aws.String(storageClass.(string))
+				}
+
+				if replicaKmsKeyId, ok := bd["replica_kms_key_id"]; ok && replicaKmsKeyId != "" {
+					ruleDestination.EncryptionConfiguration = &s3.EncryptionConfiguration{
+						ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
+					}
+				}
+
+				if account, ok := bd["account_id"]; ok && account != "" {
+					ruleDestination.Account = aws.String(account.(string))
+				}
+
+				if aclTranslation, ok := bd["access_control_translation"].([]interface{}); ok && len(aclTranslation) > 0 {
+					aclTranslationValues := aclTranslation[0].(map[string]interface{})
+					ruleAclTranslation := &s3.AccessControlTranslation{}
+					ruleAclTranslation.Owner = aws.String(aclTranslationValues["owner"].(string))
+					ruleDestination.AccessControlTranslation = ruleAclTranslation
+				}
+			}
+		}
+		rcRule.Destination = ruleDestination
+
+		if ssc, ok := rr["source_selection_criteria"].([]interface{}); ok && len(ssc) > 0 {
+			if ssc[0] != nil {
+				sscValues := ssc[0].(map[string]interface{})
+				ruleSsc := &s3.SourceSelectionCriteria{}
+				if sseKms, ok := sscValues["sse_kms_encrypted_objects"].([]interface{}); ok && len(sseKms) > 0 {
+					if sseKms[0] != nil {
+						sseKmsValues := sseKms[0].(map[string]interface{})
+						sseKmsEncryptedObjects := &s3.SseKmsEncryptedObjects{}
+						if sseKmsValues["enabled"].(bool) {
+							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusEnabled)
+						} else {
+							sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusDisabled)
+						}
+						ruleSsc.SseKmsEncryptedObjects = sseKmsEncryptedObjects
+					}
+				}
+				rcRule.SourceSelectionCriteria = ruleSsc
+			}
+		}
+
+		if f, ok := rr["filter"].([]interface{}); ok && len(f) > 0 && f[0] != nil {
+			// XML schema V2.
+			rcRule.Priority = aws.Int64(int64(rr["priority"].(int)))
+			rcRule.Filter = &s3.ReplicationRuleFilter{}
+			filter := f[0].(map[string]interface{})
+			tags := keyvaluetags.New(filter["tags"]).IgnoreAws().S3Tags()
+			if len(tags) > 0 {
+				rcRule.Filter.And = &s3.ReplicationRuleAndOperator{
+					Prefix: aws.String(filter["prefix"].(string)),
+					Tags:   tags,
+				}
+			} else {
+				rcRule.Filter.Prefix = aws.String(filter["prefix"].(string))
+			}
+
+			if dmr, ok := rr["delete_marker_replication_status"].(string); ok && dmr != "" {
+				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+					Status: aws.String(dmr),
+				}
+			} else {
+				rcRule.DeleteMarkerReplication = &s3.DeleteMarkerReplication{
+					Status: aws.String(s3.DeleteMarkerReplicationStatusDisabled),
+				}
+			}
+		} else {
+			// XML schema V1.
+			rcRule.Prefix = aws.String(rr["prefix"].(string))
+		}
+
+		rules = append(rules, rcRule)
+	}
+
+	rc.Rules = rules
+
+	return rc
+}
+
+func expandS3LifecycleRules(lifecycleRules []interface{}) ([]*s3.LifecycleRule, error) {
+	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
+
+	for _, lifecycleRule := range lifecycleRules {
+		r := lifecycleRule.(map[string]interface{})
+
+		rule := &s3.LifecycleRule{}
+
+		// Filter
+		tags := keyvaluetags.New(r["tags"]).IgnoreAws().S3Tags()
+		filter := &s3.LifecycleRuleFilter{}
+		if len(tags) > 0 {
+			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
+			lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
+			lifecycleRuleAndOp.SetTags(tags)
+			filter.SetAnd(lifecycleRuleAndOp)
+		} else {
+			filter.SetPrefix(r["prefix"].(string))
+		}
+		rule.SetFilter(filter)
+
+		// ID
+		if val, ok := r["id"].(string); ok && val != "" {
+			rule.ID = aws.String(val)
+		} else {
+			rule.ID = aws.String(resource.PrefixedUniqueId("tf-s3-lifecycle-"))
+		}
+
+		// Enabled
+		if val, ok := r["enabled"].(bool); ok && val {
+			rule.Status = aws.String(s3.ExpirationStatusEnabled)
+		} else {
+			rule.Status = aws.String(s3.ExpirationStatusDisabled)
+		}
+
+		// AbortIncompleteMultipartUpload
+		if val, ok := r["abort_incomplete_multipart_upload_days"].(int); ok && val > 0 {
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(val)),
+			}
+		}
+
+		// Expiration
+		expiration := r["expiration"].([]interface{})
+		if len(expiration) > 0 && expiration[0] != nil {
+			e := expiration[0].(map[string]interface{})
+			i := &s3.LifecycleExpiration{}
+			if val, ok := e["date"].(string); ok && val != "" {
+				t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
+				if err != nil {
+					return nil, fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
+				}
+				i.Date = aws.Time(t)
+			} else if val, ok := e["days"].(int); ok && val > 0 {
+				i.Days = aws.Int64(int64(val))
+			} else if val, ok := e["expired_object_delete_marker"].(bool); ok {
+				i.ExpiredObjectDeleteMarker = aws.Bool(val)
+			}
+			rule.Expiration = i
+		}
+
+		// NoncurrentVersionExpiration
+		nc_expiration := r["noncurrent_version_expiration"].([]interface{})
+		if len(nc_expiration) > 0 && nc_expiration[0] != nil {
+			e := nc_expiration[0].(map[string]interface{})
+
+			if val, ok := e["days"].(int); ok && val > 0 {
+				rule.NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{
+					NoncurrentDays: aws.Int64(int64(val)),
+				}
+			}
+		}
+
+		// Transitions
+		transitions := r["transition"].(*schema.Set).List()
+		if len(transitions) > 0 {
+			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
+			for _, transition := range transitions {
+				transition := transition.(map[string]interface{})
+				i := &s3.Transition{}
+				if val, ok := transition["date"].(string); ok && val != "" {
+					t, err := time.Parse(time.RFC3339, fmt.Sprintf("%sT00:00:00Z", val))
+					if err != nil {
+						return nil, fmt.Errorf("Error Parsing AWS S3 Bucket Lifecycle Expiration Date: %s", err.Error())
+					}
+					i.Date = aws.Time(t)
+				} else if val, ok := transition["days"].(int); ok && val >= 0 {
+					i.Days = aws.Int64(int64(val))
+				}
+				if val, ok := transition["storage_class"].(string); ok && val != "" {
+					i.StorageClass = aws.String(val)
+				}
+
+				rule.Transitions = append(rule.Transitions, i)
+			}
+		}
+		// NoncurrentVersionTransitions
+		nc_transitions := r["noncurrent_version_transition"].(*schema.Set).List()
+		if len(nc_transitions) > 0 {
+			rule.NoncurrentVersionTransitions = make([]*s3.NoncurrentVersionTransition, 0, len(nc_transitions))
+			for _, transition := range nc_transitions {
+				transition := transition.(map[string]interface{})
+				i := &s3.NoncurrentVersionTransition{}
+				if val, ok := transition["days"].(int); ok && val >= 0 {
+					i.NoncurrentDays = aws.Int64(int64(val))
+				}
+				if val, ok := transition["storage_class"].(string); ok && val != "" {
+					i.StorageClass = aws.String(val)
+				}
+
+				rule.NoncurrentVersionTransitions = append(rule.NoncurrentVersionTransitions, i)
+			}
+		}
+
+		// As a lifecycle rule requires 1 or more transition/expiration actions,
+		// we explicitly pass a default ExpiredObjectDeleteMarker value to be able to create
+		// the rule while keeping the policy unaffected if the conditions are not met.
+		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil &&
+			rule.Transitions == nil && rule.NoncurrentVersionTransitions == nil &&
+			rule.AbortIncompleteMultipartUpload == nil {
+			rule.Expiration = &s3.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(false)}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func flattenS3LifecycleRules(lifecycleRuleObjects []*s3.LifecycleRule) []map[string]interface{} {
+	lifecycleRules := make([]map[string]interface{}, 0, len(lifecycleRuleObjects))
+
+	for _, lifecycleRule := range lifecycleRuleObjects {
+		log.Printf("[DEBUG] S3 bucket: read lifecycle rule: %v", lifecycleRule)
+		rule := make(map[string]interface{})
+
+		// ID
+		if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
+			rule["id"] = aws.StringValue(lifecycleRule.ID)
+		}
+		filter := lifecycleRule.Filter
+		if filter != nil {
+			if filter.And != nil {
+				// Prefix
+				if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
+					rule["prefix"] = aws.StringValue(filter.And.Prefix)
+				}
+				// Tag
+				if len(filter.And.Tags) > 0 {
+					rule["tags"] = keyvaluetags.S3KeyValueTags(filter.And.Tags).IgnoreAws().Map()
+				}
+			} else {
+				// Prefix
+				if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
+					rule["prefix"] = aws.StringValue(filter.Prefix)
+				}
+				// Tag
+				if filter.Tag != nil {
+					rule["tags"] = keyvaluetags.S3KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAws().Map()
+				}
+			}
+		} else {
+			if lifecycleRule.Prefix != nil {
+				rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
+			}
+		}
+
+		// Enabled
+		if lifecycleRule.Status != nil {
+			if aws.StringValue(lifecycleRule.Status) == s3.ExpirationStatusEnabled {
+				rule["enabled"] = true
+			} else {
+				rule["enabled"] = false
+			}
+		}
+
+		// AbortIncompleteMultipartUploadDays
+		if lifecycleRule.AbortIncompleteMultipartUpload != nil {
+			if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+				rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
+			}
+		}
+
+		// expiration
+		if lifecycleRule.Expiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.Expiration.Date != nil {
+				e["date"] = (aws.TimeValue(lifecycleRule.Expiration.Date)).Format("2006-01-02")
+			}
+			if lifecycleRule.Expiration.Days != nil {
+				e["days"] = int(aws.Int64Value(lifecycleRule.Expiration.Days))
+			}
+			if lifecycleRule.Expiration.ExpiredObjectDeleteMarker != nil {
+				e["expired_object_delete_marker"] = aws.BoolValue(lifecycleRule.Expiration.ExpiredObjectDeleteMarker)
+			}
+			rule["expiration"] = []interface{}{e}
+		}
+		// noncurrent_version_expiration
+		if lifecycleRule.NoncurrentVersionExpiration != nil {
+			e := make(map[string]interface{})
+			if lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays != nil {
+				e["days"] = int(aws.Int64Value(lifecycleRule.NoncurrentVersionExpiration.NoncurrentDays))
+			}
+			rule["noncurrent_version_expiration"] = []interface{}{e}
+		}
+		//// transition
+		if len(lifecycleRule.Transitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
+			for _, v := range lifecycleRule.Transitions {
+				t := make(map[string]interface{})
+				if v.Date != nil {
+					t["date"] = (aws.TimeValue(v.Date)).Format("2006-01-02")
+				}
+				if v.Days != nil {
+					t["days"] = int(aws.Int64Value(v.Days))
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = aws.StringValue(v.StorageClass)
+				}
+				transitions = append(transitions, t)
+			}
+			rule["transition"] = schema.NewSet(transitionHash, transitions)
+		}
+		// noncurrent_version_transition
+		if len(lifecycleRule.NoncurrentVersionTransitions) > 0 {
+			transitions := make([]interface{}, 0, len(lifecycleRule.NoncurrentVersionTransitions))
+			for _, v := range lifecycleRule.NoncurrentVersionTransitions {
+				t := make(map[string]interface{})
+				if v.NoncurrentDays != nil {
+					t["days"] = int(aws.Int64Value(v.NoncurrentDays))
+				}
+				if v.StorageClass != nil {
+					t["storage_class"] = aws.StringValue(v.StorageClass)
+				}
+				transitions = append(transitions, t)
+			}
+			rule["noncurrent_version_transition"] = schema.NewSet(transitionHash, transitions)
+		}
+
+		lifecycleRules = append(lifecycleRules, rule)
+	}
+
+	return lifecycleRules
+}
+
+func s3BucketGrantSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				// TypeAmazonCustomerByEmail is not currently supported
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.TypeCanonicalUser,
+					s3.TypeGroup,
+				}, false),
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func s3BucketCorsRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"allowed_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_methods": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_origins": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expose_headers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_age_seconds": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func s3BucketVersioningSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func s3BucketLifecycleRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"abort_incomplete_multipart_upload_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"expiration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateS3BucketLifecycleTimestamp,
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"expired_object_delete_marker": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"noncurrent_version_expiration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"transition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      transitionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateS3BucketLifecycleTimestamp,
+						},
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketLifecycleTransitionStorageClass(),
+						},
+					},
+				},
+			},
+			"noncurrent_version_transition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      transitionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateS3BucketLifecycleTransitionStorageClass(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func s3BucketReplicationRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAwsAccountId,
+						},
+						"bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"storage_class": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(s3.StorageClass_Values(), false),
+						},
+						"replica_kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"access_control_translation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"owner": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.OwnerOverride_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_selection_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sse_kms_encrypted_objects": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(s3.ReplicationRuleStatus_Values(), false),
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+			"delete_marker_replication_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{s3.DeleteMarkerReplicationStatusEnabled}, false),
+			},
+		},
+	}
+}
+
+func s3BucketServerSideEncryptionRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"apply_server_side_encryption_by_default": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_master_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sse_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+						},
+					},
+				},
+			},
+			"bucket_key_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func s3BucketObjectLockRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"default_retention": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.ObjectLockRetentionMode_Values(), false),
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"years": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAclPut,
+		Read:   resourceAwsS3BucketAclRead,
+		Update: resourceAwsS3BucketAclPut,
+		Delete: resourceAwsS3BucketAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"acl", "grant"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"grant": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Set:          grantHash,
+				ExactlyOneOf: []string{"acl", "grant"},
+				Elem:         s3BucketGrantSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAclPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	if v, ok := d.GetOk("acl"); ok {
+		input := &s3.PutBucketAclInput{
+			Bucket: aws.String(bucket),
+			ACL:    aws.String(v.(string)),
+		}
+
+		log.Printf("[DEBUG] Putting S3 Bucket (%s) ACL: %s", bucket, input)
+		_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+			return conn.PutBucketAcl(input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("error putting S3 Bucket (%s) ACL: %w", bucket, err)
+		}
+	} else {
+		if err := putS3BucketGrants(conn, bucket, d.Get("grant").(*schema.Set).List()); err != nil {
+			return fmt.Errorf("error putting S3 Bucket (%s) ACL: %w", bucket, err)
+		}
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketAcl(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	// A canned ACL cannot be read back from the API, so grants are only
+	// refreshed when they are being managed explicitly.
+	if _, ok := d.GetOk("acl"); ok {
+		return nil
+	}
+
+	if err := d.Set("grant", schema.NewSet(grantHash, flattenGrants(output))); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// A bucket always has an ACL, so reset it to the default.
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(d.Id()),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	}
+
+	_, err := conn.PutBucketAcl(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return nil
+}
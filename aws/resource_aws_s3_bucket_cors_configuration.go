@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketCorsConfigurationPut,
+		Read:   resourceAwsS3BucketCorsConfigurationRead,
+		Update: resourceAwsS3BucketCorsConfigurationPut,
+		Delete: resourceAwsS3BucketCorsConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem:     s3BucketCorsRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketCorsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandS3CorsRules(d.Get("cors_rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket (%s) CORS Configuration: %s", bucket, input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketCors(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) CORS Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketCorsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketCors(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchCORSConfiguration") {
+		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("cors_rule", flattenS3CorsRules(output.CORSRules)); err != nil {
+		return fmt.Errorf("error setting cors_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketCors(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
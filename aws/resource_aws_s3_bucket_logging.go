@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketLoggingPut,
+		Read:   resourceAwsS3BucketLoggingRead,
+		Update: resourceAwsS3BucketLoggingPut,
+		Delete: resourceAwsS3BucketLoggingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketLoggingPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandS3LoggingEnabled(map[string]interface{}{
+				"target_bucket": d.Get("target_bucket"),
+				"target_prefix": d.Get("target_prefix"),
+			}),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket (%s) Logging: %s", bucket, input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketLogging(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Logging: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketLoggingRead(d, meta)
+}
+
+func resourceAwsS3BucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketLogging(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Logging: empty response", d.Id())
+	}
+
+	if !d.IsNewResource() && output.LoggingEnabled == nil {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bucket", d.Id())
+
+	if output.LoggingEnabled != nil {
+		logging := flattenS3LoggingEnabled(output.LoggingEnabled)
+
+		d.Set("target_bucket", logging["target_bucket"])
+		d.Set("target_prefix", logging["target_prefix"])
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// An empty logging status disables logging.
+	input := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	}
+
+	_, err := conn.PutBucketLogging(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	return nil
+}
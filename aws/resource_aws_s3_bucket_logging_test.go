@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSS3BucketLogging_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_logging.test"
+	targetBucketResourceName := "aws_s3_bucket.log_bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_bucket", targetBucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "other/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_prefix", "other/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketLogging_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketLoggingConfig(rName, "log/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketLoggingExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsS3BucketLogging(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketLoggingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_logging" {
+			continue
+		}
+
+		input := &s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetBucketLogging(input)
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.LoggingEnabled != nil {
+			return fmt.Errorf("S3 Bucket Logging (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketLoggingExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		input := &s3.GetBucketLoggingInput{
+			Bucket: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetBucketLogging(input)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.LoggingEnabled == nil {
+			return fmt.Errorf("S3 Bucket Logging (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketLoggingConfig(rName, targetPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "%[1]s-log"
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_logging" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  target_bucket = aws_s3_bucket.log_bucket.id
+  target_prefix = %[2]q
+}
+`, rName, targetPrefix)
+}
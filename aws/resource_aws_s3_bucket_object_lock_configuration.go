@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketObjectLockConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectLockConfigurationPut,
+		Read:   resourceAwsS3BucketObjectLockConfigurationRead,
+		Update: resourceAwsS3BucketObjectLockConfigurationPut,
+		Delete: resourceAwsS3BucketObjectLockConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"object_lock_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.ObjectLockEnabledEnabled,
+				ValidateFunc: validation.StringInSlice(s3.ObjectLockEnabled_Values(), false),
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     s3BucketObjectLockRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketObjectLockConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(bucket),
+		ObjectLockConfiguration: expandS3ObjectLockConfiguration([]interface{}{
+			map[string]interface{}{
+				"object_lock_enabled": d.Get("object_lock_enabled"),
+				"rule":                d.Get("rule"),
+			},
+		}),
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket (%s) Object Lock Configuration: %s", bucket, input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutObjectLockConfiguration(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Object Lock Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketObjectLockConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectLockConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetObjectLockConfiguration(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Object Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "ObjectLockConfigurationNotFoundError") {
+		log.Printf("[WARN] S3 Bucket Object Lock Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ObjectLockConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Object Lock Configuration: empty response", d.Id())
+	}
+
+	conf := flattenS3ObjectLockConfiguration(output.ObjectLockConfiguration)[0].(map[string]interface{})
+
+	d.Set("bucket", d.Id())
+	d.Set("object_lock_enabled", conf["object_lock_enabled"])
+
+	if err := d.Set("rule", conf["rule"]); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectLockConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// S3 Object Lock cannot be disabled once enabled, only the default retention rule removed.
+	input := &s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String(d.Id()),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String(d.Get("object_lock_enabled").(string)),
+		},
+	}
+
+	_, err := conn.PutObjectLockConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Object Lock Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
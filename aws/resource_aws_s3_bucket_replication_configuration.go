@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketReplicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketReplicationConfigurationPut,
+		Read:   resourceAwsS3BucketReplicationConfigurationRead,
+		Update: resourceAwsS3BucketReplicationConfigurationPut,
+		Delete: resourceAwsS3BucketReplicationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      rulesHash,
+				Elem:     s3BucketReplicationRuleSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	rc := expandS3ReplicationConfiguration(map[string]interface{}{
+		"role":  d.Get("role"),
+		"rules": d.Get("rules"),
+	})
+
+	// The bucket's versioning may be managed by a separate resource, so rely on the
+	// API (with retries for eventual consistency) to enforce that it is enabled.
+	if err := putS3BucketReplicationConfiguration(conn, bucket, rc); err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Replication Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketReplicationConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketReplication(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "ReplicationConfigurationNotFoundError") {
+		log.Printf("[WARN] S3 Bucket Replication Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil || output.ReplicationConfiguration == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Replication Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	replicationConfiguration := flattenAwsS3BucketReplicationConfiguration(output.ReplicationConfiguration)[0]
+
+	d.Set("role", replicationConfiguration["role"])
+
+	if err := d.Set("rules", replicationConfiguration["rules"]); err != nil {
+		return fmt.Errorf("error setting rules: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketReplicationInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketReplication(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Replication Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
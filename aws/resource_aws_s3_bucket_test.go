@@ -697,7 +697,7 @@ func TestAccAWSS3Bucket_Security_updateGrant(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccAWSS3BucketConfig_Basic(bucketName),
+				Config: testAccAWSS3BucketConfigWithAclUpdate(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "grant.#", "0"),
@@ -804,8 +804,9 @@ func TestAccAWSS3Bucket_Web_simple(t *testing.T) {
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					// website is Computed, so removing it from configuration leaves the existing website configuration in place.
+					resource.TestCheckResourceAttr(resourceName, "website.#", "1"),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -849,8 +850,9 @@ func TestAccAWSS3Bucket_Web_redirect(t *testing.T) {
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					// website is Computed, so removing it from configuration leaves the existing website configuration in place.
+					resource.TestCheckResourceAttr(resourceName, "website.#", "1"),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -900,9 +902,9 @@ func TestAccAWSS3Bucket_Web_routingRules(t *testing.T) {
 				Config: testAccAWSS3BucketConfig_Basic(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					testAccCheckAWSS3BucketWebsite(resourceName, "", "", "", ""),
-					testAccCheckAWSS3BucketWebsiteRoutingRules(resourceName, nil),
-					resource.TestCheckResourceAttr(resourceName, "website_endpoint", ""),
+					// website is Computed, so removing it from configuration leaves the existing website configuration in place.
+					resource.TestCheckResourceAttr(resourceName, "website.#", "1"),
+					testAccCheckS3BucketWebsiteEndpoint(resourceName, "website_endpoint", bucketName, region),
 				),
 			},
 		},
@@ -997,7 +999,8 @@ func TestAccAWSS3Bucket_Security_disableDefaultEncryptionWhenDefaultEncryptionIs
 				Config: testAccAWSS3BucketDisableDefaultEncryption(bucketName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAWSS3BucketExists(resourceName),
-					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.#", "0"),
+					// server_side_encryption_configuration is Computed, so removing it from configuration leaves the existing encryption in place.
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption_configuration.#", "1"),
 				),
 			},
 		},
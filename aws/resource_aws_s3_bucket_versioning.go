@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketVersioningPut,
+		Read:   resourceAwsS3BucketVersioningRead,
+		Update: resourceAwsS3BucketVersioningPut,
+		Delete: resourceAwsS3BucketVersioningDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem:     s3BucketVersioningSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketVersioningPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucket),
+		VersioningConfiguration: expandS3VersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket (%s) Versioning: %s", bucket, input)
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketVersioning(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Versioning: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketVersioningRead(d, meta)
+}
+
+func resourceAwsS3BucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketVersioning(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Versioning (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Versioning: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Versioning: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("versioning_configuration", []interface{}{flattenS3VersioningConfiguration(output)}); err != nil {
+		return fmt.Errorf("error setting versioning_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	// Versioning cannot be disabled once enabled, only suspended.
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+		VersioningConfiguration: &s3.VersioningConfiguration{
+			Status: aws.String(s3.BucketVersioningStatusSuspended),
+		},
+	}
+
+	_, err := conn.PutBucketVersioning(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error suspending S3 Bucket (%s) Versioning: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketWebsiteConfigurationPut,
+		Read:   resourceAwsS3BucketWebsiteConfigurationRead,
+		Update: resourceAwsS3BucketWebsiteConfigurationPut,
+		Delete: resourceAwsS3BucketWebsiteConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"error_document": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"index_document": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"index_document", "redirect_all_requests_to"},
+			},
+			"redirect_all_requests_to": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"error_document", "index_document", "routing_rules"},
+				AtLeastOneOf:  []string{"index_document", "redirect_all_requests_to"},
+			},
+			"routing_rules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketWebsiteConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	websiteConfiguration, err := expandS3WebsiteConfiguration(map[string]interface{}{
+		"error_document":           d.Get("error_document"),
+		"index_document":           d.Get("index_document"),
+		"redirect_all_requests_to": d.Get("redirect_all_requests_to"),
+		"routing_rules":            d.Get("routing_rules"),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: websiteConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 Bucket (%s) Website Configuration: %s", bucket, input)
+	_, err = retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketWebsite(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) Website Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketWebsite(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchWebsiteConfiguration") {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: empty response", d.Id())
+	}
+
+	website, err := flattenS3WebsiteConfiguration(output)
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: %w", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	d.Set("error_document", website["error_document"])
+	d.Set("index_document", website["index_document"])
+	d.Set("redirect_all_requests_to", website["redirect_all_requests_to"])
+	d.Set("routing_rules", website["routing_rules"])
+
+	websiteEndpoint, err := bucketWebsiteEndpoint(meta.(*AWSClient), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration endpoint: %w", d.Id(), err)
+	}
+
+	d.Set("website_domain", websiteEndpoint.Domain)
+	d.Set("website_endpoint", websiteEndpoint.Endpoint)
+
+	return nil
+}
+
+func resourceAwsS3BucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketWebsite(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Website Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}